@@ -0,0 +1,144 @@
+package llm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ChatStreamChunk is one incremental piece of a streamed chat completion.
+// Err is set (and the channel closed) when the stream ends abnormally.
+// Done is set on the chunk carrying a choice's finish_reason and again on
+// the usage chunk that follows it. Usage is only populated on that usage
+// chunk, since OpenAI reports it once the stream completes rather than
+// per-delta.
+type ChatStreamChunk struct {
+	Content string
+	Done    bool
+	Usage   Usage
+	Err     error
+}
+
+// ChatStream sends chatMessages to OpenAI with streaming enabled and returns
+// a channel of incremental chunks carrying each choice's delta content. The
+// channel is closed when the server sends "data: [DONE]", when ctx is
+// cancelled, or when an error frame/read error occurs.
+func (s *OpenAIStrategy) ChatStream(ctx context.Context, chatMessages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error) {
+	request := map[string]interface{}{
+		"model":    options.Model,
+		"messages": chatMessages,
+		"stream":   true,
+	}
+	if options.Temperature != nil {
+		request["temperature"] = *options.Temperature
+	}
+	if options.MaxTokens != nil {
+		request["max_tokens"] = *options.MaxTokens
+	}
+	if options.TopP != nil {
+		request["top_p"] = *options.TopP
+	}
+	if options.Stop != nil {
+		request["stop"] = options.Stop
+	}
+	request["stream_options"] = map[string]bool{"include_usage": true}
+
+	body, err := s.httpClient.PostStream(ctx, s.config.ChatURL, request)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAI chat stream request failed: %w", err)
+	}
+
+	chunks := make(chan ChatStreamChunk)
+	go streamChatSSE(ctx, body, chunks)
+
+	return chunks, nil
+}
+
+// streamChatSSE reads an OpenAI SSE response line by line and emits parsed
+// chunks until [DONE], ctx cancellation, or an error frame/read error.
+func streamChatSSE(ctx context.Context, body io.ReadCloser, chunks chan<- ChatStreamChunk) {
+	defer close(chunks)
+	defer body.Close()
+
+	// send delivers chunk to the caller, but gives up and returns false
+	// instead of blocking forever if ctx is cancelled before the (mandatory
+	// unbuffered, possibly-undrained) consumer receives it.
+	send := func(chunk ChatStreamChunk) bool {
+		select {
+		case chunks <- chunk:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			send(ChatStreamChunk{Err: ctx.Err()})
+			return
+		default:
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue // skip blank lines and keep-alive comments
+		}
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			return
+		}
+
+		var event openAIChatStreamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			send(ChatStreamChunk{Err: fmt.Errorf("failed to unmarshal chat stream chunk: %w", err)})
+			return
+		}
+		if event.Error != nil {
+			send(ChatStreamChunk{Err: fmt.Errorf("OpenAI chat stream error: %s", event.Error.Message)})
+			return
+		}
+		if event.Usage != nil {
+			if !send(ChatStreamChunk{Done: true, Usage: *event.Usage}) {
+				return
+			}
+			continue
+		}
+		if len(event.Choices) == 0 {
+			continue
+		}
+
+		choice := event.Choices[0]
+		if !send(ChatStreamChunk{
+			Content: choice.Delta.Content,
+			Done:    choice.FinishReason != "",
+		}) {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		send(ChatStreamChunk{Err: fmt.Errorf("error reading chat stream: %w", err)})
+	}
+}
+
+type openAIChatStreamEvent struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *Usage `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
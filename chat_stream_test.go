@@ -0,0 +1,84 @@
+package llm
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type closeTrackingReader struct {
+	io.Reader
+	closed chan struct{}
+}
+
+func (r *closeTrackingReader) Close() error {
+	close(r.closed)
+	return nil
+}
+
+func newSSEBody(lines ...string) *closeTrackingReader {
+	return &closeTrackingReader{
+		Reader: strings.NewReader(strings.Join(lines, "\n") + "\n"),
+		closed: make(chan struct{}),
+	}
+}
+
+func TestStreamChatSSEDeliversContentAndUsage(t *testing.T) {
+	body := newSSEBody(
+		`data: {"choices":[{"delta":{"content":"hel"}}]}`,
+		``,
+		`data: {"choices":[{"delta":{"content":"lo"},"finish_reason":"stop"}]}`,
+		``,
+		`data: {"choices":[],"usage":{"prompt_tokens":1,"completion_tokens":2,"total_tokens":3}}`,
+		``,
+		`data: [DONE]`,
+	)
+
+	chunks := make(chan ChatStreamChunk)
+	go streamChatSSE(context.Background(), body, chunks)
+
+	var got []ChatStreamChunk
+	for chunk := range chunks {
+		got = append(got, chunk)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 chunks, got %d: %+v", len(got), got)
+	}
+	if got[0].Content != "hel" || got[1].Content != "lo" || !got[1].Done {
+		t.Fatalf("unexpected content chunks: %+v", got[:2])
+	}
+	last := got[2]
+	if !last.Done || last.Usage.TotalTokens != 3 {
+		t.Fatalf("expected final chunk to carry usage, got %+v", last)
+	}
+}
+
+// TestStreamChatSSEClosesBodyWhenConsumerStopsDraining guards against the
+// goroutine/body leak this fix addresses: if ctx is cancelled while the
+// consumer has stopped reading from chunks, streamChatSSE must still return
+// (and close body) instead of blocking forever on an unbuffered send.
+func TestStreamChatSSEClosesBodyWhenConsumerStopsDraining(t *testing.T) {
+	body := newSSEBody(
+		`data: {"choices":[{"delta":{"content":"first"}}]}`,
+		``,
+		`data: {"choices":[{"delta":{"content":"second"}}]}`,
+		``,
+		`data: [DONE]`,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chunks := make(chan ChatStreamChunk)
+	go streamChatSSE(ctx, body, chunks)
+
+	<-chunks // drain exactly one chunk, then stop draining
+	cancel()
+
+	select {
+	case <-body.closed:
+	case <-time.After(time.Second):
+		t.Fatal("body was never closed: streamChatSSE goroutine leaked")
+	}
+}
@@ -0,0 +1,124 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+const (
+	defaultMaxItemsPerEmbedBatch  = 100
+	defaultMaxTokensPerEmbedBatch = 8000
+	defaultEmbedConcurrency       = 4
+)
+
+// embedBatch is one sub-batch of the original texts slice, tracking where
+// its items sit in the caller's input order so results can be merged back.
+type embedBatch struct {
+	texts       []string
+	startOffset int
+}
+
+// splitEmbedBatches partitions texts into batches bounded by both maxItems
+// and maxTokens (as measured by tokenizer), preserving input order. A
+// single text that alone exceeds maxTokens still gets its own batch rather
+// than being dropped.
+func splitEmbedBatches(texts []string, tokenizer Tokenizer, maxItems, maxTokens int) []embedBatch {
+	var batches []embedBatch
+	var current []string
+	currentTokens := 0
+	start := 0
+
+	flush := func() {
+		if len(current) > 0 {
+			batches = append(batches, embedBatch{texts: current, startOffset: start})
+		}
+	}
+
+	for i, text := range texts {
+		tokens := tokenizer.CountTokens(text)
+		wouldOverflow := len(current) > 0 && (len(current)+1 > maxItems || currentTokens+tokens > maxTokens)
+		if wouldOverflow {
+			flush()
+			current = nil
+			currentTokens = 0
+			start = i
+		}
+		current = append(current, text)
+		currentTokens += tokens
+	}
+	flush()
+
+	return batches
+}
+
+// runEmbedBatches dispatches each batch to embedOne with at most
+// maxConcurrency requests in flight, preserves input order in the returned
+// embeddings, and merges each batch's usage into a single total. The
+// RateLimitInfo returned is from batch 0 only: per-batch rate-limit state
+// can't be meaningfully merged once requests run concurrently.
+func runEmbedBatches(ctx context.Context, batches []embedBatch, totalTexts int, maxConcurrency int, embedOne func(ctx context.Context, texts []string) ([][]float32, Usage, RateLimitInfo, error)) ([][]float32, Usage, RateLimitInfo, error) {
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultEmbedConcurrency
+	}
+
+	results := make([][]float32, totalTexts)
+	usages := make([]Usage, len(batches))
+	rateLimits := make([]RateLimitInfo, len(batches))
+	errs := make([]error, len(batches))
+
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
+	for i, batch := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, batch embedBatch) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			embeddings, usage, rateLimit, err := embedOne(ctx, batch.texts)
+			if err != nil {
+				errs[i] = fmt.Errorf("embedding batch starting at index %d: %w", batch.startOffset, err)
+				return
+			}
+			for j, embedding := range embeddings {
+				results[batch.startOffset+j] = embedding
+			}
+			usages[i] = usage
+			rateLimits[i] = rateLimit
+		}(i, batch)
+	}
+	wg.Wait()
+
+	var total Usage
+	for i, err := range errs {
+		if err != nil {
+			return nil, Usage{}, RateLimitInfo{}, err
+		}
+		total = total.Add(usages[i])
+	}
+
+	var rateLimit RateLimitInfo
+	if len(rateLimits) > 0 {
+		rateLimit = rateLimits[0]
+	}
+
+	return results, total, rateLimit, nil
+}
+
+// normalizeL2 scales embedding to unit length in place.
+func normalizeL2(embedding []float32) {
+	var sumSquares float64
+	for _, v := range embedding {
+		sumSquares += float64(v) * float64(v)
+	}
+	if sumSquares == 0 {
+		return
+	}
+	norm := math.Sqrt(sumSquares)
+	for i, v := range embedding {
+		embedding[i] = float32(float64(v) / norm)
+	}
+}
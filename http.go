@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// jsonHTTPClient issues the JSON POST requests OpenAIStrategy needs against
+// net/http directly. gohttpclient.Client (github.com/simp-lee/gohttpclient)
+// only exposes Post/Request, which buffer the whole response into memory,
+// apply the client's overall Timeout to that entire buffered read, and
+// don't return response headers — none of which work for rate-limit header
+// inspection (postWithRateLimitRetry) or a live SSE body (ChatStream), so
+// those two needs are served directly instead of through that dependency.
+type jsonHTTPClient struct {
+	apiKey         string
+	blockingClient *http.Client // bounded by Timeout; used for ordinary request/response calls
+	streamClient   *http.Client // no Timeout: that would bound the entire streamed read, not just setup
+}
+
+func newJSONHTTPClient(apiKey string, timeout time.Duration) *jsonHTTPClient {
+	return &jsonHTTPClient{
+		apiKey:         apiKey,
+		blockingClient: &http.Client{Timeout: timeout},
+		streamClient:   &http.Client{},
+	}
+}
+
+func (c *jsonHTTPClient) newRequest(ctx context.Context, url string, body any, streaming bool) (*http.Request, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	req.Header.Set("Content-Type", "application/json")
+	if streaming {
+		req.Header.Set("Accept", "text/event-stream")
+	}
+	return req, nil
+}
+
+// Post sends request to url and returns the buffered response body
+// alongside its headers, so callers can parse rate-limit information. A
+// non-2xx response is returned as an *httpStatusError, so callers can
+// branch on the actual status code instead of matching response body text.
+func (c *jsonHTTPClient) Post(ctx context.Context, url string, request any) ([]byte, http.Header, error) {
+	req, err := c.newRequest(ctx, url, request, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.blockingClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.Header, fmt.Errorf("read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.Header, &httpStatusError{StatusCode: resp.StatusCode, Body: body}
+	}
+	return body, resp.Header, nil
+}
+
+// PostStream sends request to url and returns the live response body for
+// line-by-line reading. The caller owns the returned body and must close
+// it. A non-2xx response is returned as an *httpStatusError, same as Post.
+func (c *jsonHTTPClient) PostStream(ctx context.Context, url string, request any) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, url, request, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &httpStatusError{StatusCode: resp.StatusCode, Body: body}
+	}
+	return resp.Body, nil
+}
+
+// httpStatusError is a non-2xx HTTP response, carrying the real status
+// code so callers can decide retryability without matching the body text
+// (which may itself contain digits that look like a status code).
+type httpStatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, string(e.Body))
+}
@@ -0,0 +1,173 @@
+// Package jsonschema builds JSON Schema definitions for use with OpenAI's
+// tool-calling and structured-output ("response_format") APIs, either by
+// hand or by reflecting over a tagged Go struct.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DataType is a JSON Schema primitive or container type.
+type DataType string
+
+const (
+	Object  DataType = "object"
+	Array   DataType = "array"
+	String  DataType = "string"
+	Number  DataType = "number"
+	Integer DataType = "integer"
+	Boolean DataType = "boolean"
+	Null    DataType = "null"
+)
+
+// Definition is a (subset of) JSON Schema node. It marshals to the shape
+// OpenAI expects for `tools[].function.parameters` and
+// `response_format.json_schema.schema`.
+type Definition struct {
+	Type        DataType              `json:"type,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Properties  map[string]Definition `json:"properties,omitempty"`
+	Required    []string              `json:"required,omitempty"`
+	Items       *Definition           `json:"items,omitempty"`
+	Enum        []any                 `json:"enum,omitempty"`
+}
+
+// MarshalJSON emits Properties as {} rather than null when Type is Object
+// with no declared fields, which OpenAI's schema validator requires.
+func (d Definition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.asMap())
+}
+
+func (d Definition) asMap() map[string]any {
+	m := map[string]any{}
+	if d.Type != "" {
+		m["type"] = d.Type
+	}
+	if d.Description != "" {
+		m["description"] = d.Description
+	}
+	if d.Type == Object {
+		props := map[string]any{}
+		for name, prop := range d.Properties {
+			props[name] = prop.asMap()
+		}
+		m["properties"] = props
+	}
+	if len(d.Required) > 0 {
+		m["required"] = d.Required
+	}
+	if d.Items != nil {
+		m["items"] = d.Items.asMap()
+	}
+	if len(d.Enum) > 0 {
+		m["enum"] = d.Enum
+	}
+	return m
+}
+
+// DefinitionFromStruct reflects over a (pointer to a) Go struct and builds
+// an object Definition from its exported fields, honoring `json:"name"` for
+// the property name and `description:"..."` for the property description.
+// A field tagged `json:",omitempty"` is treated as optional; all other
+// fields are added to Required.
+func DefinitionFromStruct(v any) (Definition, error) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return Definition{}, fmt.Errorf("jsonschema: %s is not a struct", t.Kind())
+	}
+
+	def := Definition{Type: Object, Properties: map[string]Definition{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name, omitempty := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		prop, err := definitionFromType(field.Type)
+		if err != nil {
+			return Definition{}, fmt.Errorf("jsonschema: field %s: %w", field.Name, err)
+		}
+		prop.Description = field.Tag.Get("description")
+
+		def.Properties[name] = prop
+		if !omitempty {
+			def.Required = append(def.Required, name)
+		}
+	}
+
+	return def, nil
+}
+
+func fieldName(field reflect.StructField) (name string, omitempty bool) {
+	tag := field.Tag.Get("json")
+	parts := strings.Split(tag, ",")
+	name = field.Name
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+func definitionFromType(t reflect.Type) (Definition, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Definition{Type: String}, nil
+	case reflect.Bool:
+		return Definition{Type: Boolean}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Definition{Type: Integer}, nil
+	case reflect.Float32, reflect.Float64:
+		return Definition{Type: Number}, nil
+	case reflect.Slice, reflect.Array:
+		item, err := definitionFromType(t.Elem())
+		if err != nil {
+			return Definition{}, err
+		}
+		return Definition{Type: Array, Items: &item}, nil
+	case reflect.Struct:
+		def := Definition{Type: Object, Properties: map[string]Definition{}}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name, omitempty := fieldName(field)
+			if name == "-" {
+				continue
+			}
+			prop, err := definitionFromType(field.Type)
+			if err != nil {
+				return Definition{}, err
+			}
+			prop.Description = field.Tag.Get("description")
+			def.Properties[name] = prop
+			if !omitempty {
+				def.Required = append(def.Required, name)
+			}
+		}
+		return def, nil
+	default:
+		return Definition{}, fmt.Errorf("unsupported kind %s", t.Kind())
+	}
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/simp-lee/llmconnector"
+)
+
+// MultiBackendStrategy dispatches to a different gRPC backend per model
+// name, mirroring the model-loader pattern used by LocalAI-style servers:
+// each model is served by whichever process/endpoint currently has it
+// loaded, and callers only ever see a single model-keyed Strategy.
+type MultiBackendStrategy struct {
+	mu       sync.RWMutex
+	backends map[string]*GRPCStrategy
+}
+
+// NewMultiBackendStrategy returns an empty MultiBackendStrategy; use
+// AddBackend to register a model name's endpoint.
+func NewMultiBackendStrategy() *MultiBackendStrategy {
+	return &MultiBackendStrategy{backends: make(map[string]*GRPCStrategy)}
+}
+
+// AddBackend registers backend as the endpoint serving model.
+func (m *MultiBackendStrategy) AddBackend(model string, backend *GRPCStrategy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.backends[model] = backend
+}
+
+func (m *MultiBackendStrategy) resolve(model string) (*GRPCStrategy, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	backend, ok := m.backends[model]
+	if !ok {
+		return nil, fmt.Errorf("grpc: no backend registered for model %q", model)
+	}
+	return backend, nil
+}
+
+var _ llm.Strategy = (*MultiBackendStrategy)(nil)
+
+func (m *MultiBackendStrategy) Chat(ctx context.Context, messages []llm.ChatMessage, options *llm.ChatOptions) (llm.ChatResponse, error) {
+	backend, err := m.resolve(options.Model)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Chat(ctx, messages, options)
+}
+
+func (m *MultiBackendStrategy) ChatStream(ctx context.Context, messages []llm.ChatMessage, options *llm.ChatOptions) (<-chan llm.ChatStreamChunk, error) {
+	backend, err := m.resolve(options.Model)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ChatStream(ctx, messages, options)
+}
+
+func (m *MultiBackendStrategy) Embed(ctx context.Context, texts []string, options *llm.EmbedOptions) (llm.EmbedResponse, error) {
+	backend, err := m.resolve(options.Model)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Embed(ctx, texts, options)
+}
@@ -0,0 +1,34 @@
+package proto
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// codecName is the gRPC content-subtype used for every LLMService call.
+const codecName = "llmconnector-json"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec marshals LLMService messages as JSON instead of the protobuf
+// wire format. This package's message types are plain structs (this repo
+// has no protoc/protoc-gen-go available to generate real proto.Message
+// implementations), so the default protobuf codec can't encode them; this
+// codec is registered with grpc's encoding package and selected on every
+// call via Codec() so client and server agree on it.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                       { return codecName }
+
+// Codec returns the CallOption every LLMServiceClient call uses to select
+// jsonCodec; the server side picks it up automatically from the resulting
+// content-subtype via the encoding.RegisterCodec registration above.
+func Codec() grpc.CallOption {
+	return grpc.ForceCodec(jsonCodec{})
+}
@@ -0,0 +1,68 @@
+// Package proto defines the LLMService message and service types by hand:
+// this repo has no protoc/protoc-gen-go/protoc-gen-go-grpc available to
+// generate real proto.Message implementations from an llm.proto source, so
+// these are plain structs dispatched over a custom JSON grpc.Codec (see
+// codec.go) rather than the protobuf wire format. Edit freely — nothing
+// here is machine-generated.
+package proto
+
+type ChatMessage struct {
+	Role    string
+	Content string
+}
+
+type ChatRequest struct {
+	Model       string
+	Messages    []*ChatMessage
+	Temperature *float32
+	MaxTokens   *int32
+	TopP        *float32
+	Stop        []string
+}
+
+type ChatResponse struct {
+	Content string
+	Usage   *Usage
+}
+
+type ChatStreamChunk struct {
+	Content string
+	Done    bool
+}
+
+type EmbedRequest struct {
+	Model string
+	Texts []string
+}
+
+type EmbedResponse struct {
+	Embeddings []*Embedding
+	Usage      *Usage
+}
+
+type Embedding struct {
+	Values []float32
+}
+
+type Usage struct {
+	PromptTokens     int32
+	CompletionTokens int32
+	TotalTokens      int32
+}
+
+type HealthRequest struct{}
+
+type HealthResponse struct {
+	Ready  bool
+	Status string
+}
+
+type LoadModelRequest struct {
+	Model string
+	Path  string
+}
+
+type LoadModelResponse struct {
+	Loaded bool
+	Error  string
+}
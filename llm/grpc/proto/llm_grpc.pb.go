@@ -0,0 +1,245 @@
+// This file defines the LLMService client/server stubs by hand, in place
+// of protoc-gen-go-grpc output (see llm.pb.go for why). The dispatch below
+// (ServiceDesc.Methods/Streams, the *_Handler functions) is real: every RPC
+// listed on LLMServiceClient/LLMServiceServer is actually registered and
+// served, via the JSON codec in codec.go.
+
+package proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LLMServiceClient is the client API for LLMService.
+type LLMServiceClient interface {
+	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error)
+	ChatStream(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (LLMService_ChatStreamClient, error)
+	Embed(ctx context.Context, in *EmbedRequest, opts ...grpc.CallOption) (*EmbedResponse, error)
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	LoadModel(ctx context.Context, in *LoadModelRequest, opts ...grpc.CallOption) (*LoadModelResponse, error)
+}
+
+// LLMService_ChatStreamClient is the streaming handle returned by ChatStream.
+type LLMService_ChatStreamClient interface {
+	Recv() (*ChatStreamChunk, error)
+	grpc.ClientStream
+}
+
+// LLMServiceServer is the server API for LLMService.
+type LLMServiceServer interface {
+	Chat(context.Context, *ChatRequest) (*ChatResponse, error)
+	ChatStream(*ChatRequest, LLMService_ChatStreamServer) error
+	Embed(context.Context, *EmbedRequest) (*EmbedResponse, error)
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	LoadModel(context.Context, *LoadModelRequest) (*LoadModelResponse, error)
+}
+
+// LLMService_ChatStreamServer is the streaming handle passed to a server's
+// ChatStream implementation.
+type LLMService_ChatStreamServer interface {
+	Send(*ChatStreamChunk) error
+	grpc.ServerStream
+}
+
+// NewLLMServiceClient wraps conn with the client stub. Every call is forced
+// onto the JSON codec (see codec.go), since these message types aren't real
+// proto.Message implementations.
+func NewLLMServiceClient(conn grpc.ClientConnInterface) LLMServiceClient {
+	return &llmServiceClient{conn}
+}
+
+type llmServiceClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c *llmServiceClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*ChatResponse, error) {
+	out := new(ChatResponse)
+	opts = append(opts, Codec())
+	if err := c.conn.Invoke(ctx, "/llm.LLMService/Chat", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmServiceClient) ChatStream(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (LLMService_ChatStreamClient, error) {
+	opts = append(opts, Codec())
+	stream, err := c.conn.NewStream(ctx, &_LLMService_ChatStream_serviceDesc, "/llm.LLMService/ChatStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return &llmServiceChatStreamClient{stream}, nil
+}
+
+type llmServiceChatStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *llmServiceChatStreamClient) Recv() (*ChatStreamChunk, error) {
+	m := new(ChatStreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *llmServiceClient) Embed(ctx context.Context, in *EmbedRequest, opts ...grpc.CallOption) (*EmbedResponse, error) {
+	out := new(EmbedResponse)
+	opts = append(opts, Codec())
+	if err := c.conn.Invoke(ctx, "/llm.LLMService/Embed", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	opts = append(opts, Codec())
+	if err := c.conn.Invoke(ctx, "/llm.LLMService/Health", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmServiceClient) LoadModel(ctx context.Context, in *LoadModelRequest, opts ...grpc.CallOption) (*LoadModelResponse, error) {
+	out := new(LoadModelResponse)
+	opts = append(opts, Codec())
+	if err := c.conn.Invoke(ctx, "/llm.LLMService/LoadModel", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _LLMService_ChatStream_serviceDesc = grpc.StreamDesc{
+	StreamName:    "ChatStream",
+	Handler:       _LLMService_ChatStream_Handler,
+	ServerStreams: true,
+}
+
+func _LLMService_ChatStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LLMServiceServer).ChatStream(m, &llmServiceChatStreamServer{stream})
+}
+
+type llmServiceChatStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *llmServiceChatStreamServer) Send(m *ChatStreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LLMService_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/llm.LLMService/Chat"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMService_Embed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).Embed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/llm.LLMService/Embed"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).Embed(ctx, req.(*EmbedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/llm.LLMService/Health"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMService_LoadModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).LoadModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/llm.LLMService/LoadModel"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).LoadModel(ctx, req.(*LoadModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UnimplementedLLMServiceServer can be embedded in a server implementation
+// for forward compatibility: methods not overridden return Unimplemented.
+type UnimplementedLLMServiceServer struct{}
+
+func (UnimplementedLLMServiceServer) Chat(context.Context, *ChatRequest) (*ChatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedLLMServiceServer) ChatStream(*ChatRequest, LLMService_ChatStreamServer) error {
+	return status.Error(codes.Unimplemented, "method ChatStream not implemented")
+}
+func (UnimplementedLLMServiceServer) Embed(context.Context, *EmbedRequest) (*EmbedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Embed not implemented")
+}
+func (UnimplementedLLMServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedLLMServiceServer) LoadModel(context.Context, *LoadModelRequest) (*LoadModelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoadModel not implemented")
+}
+
+// RegisterLLMServiceServer registers srv with s under the LLMService name.
+func RegisterLLMServiceServer(s grpc.ServiceRegistrar, srv LLMServiceServer) {
+	s.RegisterService(&_LLMService_serviceDesc, srv)
+}
+
+var _LLMService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "llm.LLMService",
+	HandlerType: (*LLMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Chat", Handler: _LLMService_Chat_Handler},
+		{MethodName: "Embed", Handler: _LLMService_Embed_Handler},
+		{MethodName: "Health", Handler: _LLMService_Health_Handler},
+		{MethodName: "LoadModel", Handler: _LLMService_LoadModel_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChatStream",
+			Handler:       _LLMService_ChatStream_Handler,
+			ServerStreams: true,
+		},
+	},
+}
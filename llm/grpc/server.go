@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/simp-lee/llmconnector/llm/grpc/proto"
+	"google.golang.org/grpc"
+)
+
+// Server is a reference LLMService implementation that backends (llama.cpp,
+// whisper, bert, ...) can embed and fill in with their own inference calls.
+// The zero value answers Health with ready=false until SetReady is called.
+type Server struct {
+	proto.UnimplementedLLMServiceServer
+
+	ready bool
+
+	ChatFunc       func(ctx context.Context, req *proto.ChatRequest) (*proto.ChatResponse, error)
+	ChatStreamFunc func(req *proto.ChatRequest, send func(*proto.ChatStreamChunk) error) error
+	EmbedFunc      func(ctx context.Context, req *proto.EmbedRequest) (*proto.EmbedResponse, error)
+	LoadModelFunc  func(ctx context.Context, req *proto.LoadModelRequest) (*proto.LoadModelResponse, error)
+}
+
+// SetReady marks the server ready (or not) for Health checks, e.g. once
+// model weights have finished loading.
+func (s *Server) SetReady(ready bool) {
+	s.ready = ready
+}
+
+// Register attaches s to grpcServer under the LLMService name.
+func (s *Server) Register(grpcServer *grpc.Server) {
+	proto.RegisterLLMServiceServer(grpcServer, s)
+}
+
+func (s *Server) Chat(ctx context.Context, req *proto.ChatRequest) (*proto.ChatResponse, error) {
+	if s.ChatFunc == nil {
+		return nil, fmt.Errorf("grpc: Chat not implemented by this backend")
+	}
+	return s.ChatFunc(ctx, req)
+}
+
+func (s *Server) ChatStream(req *proto.ChatRequest, stream proto.LLMService_ChatStreamServer) error {
+	if s.ChatStreamFunc == nil {
+		return fmt.Errorf("grpc: ChatStream not implemented by this backend")
+	}
+	return s.ChatStreamFunc(req, stream.Send)
+}
+
+func (s *Server) Embed(ctx context.Context, req *proto.EmbedRequest) (*proto.EmbedResponse, error) {
+	if s.EmbedFunc == nil {
+		return nil, fmt.Errorf("grpc: Embed not implemented by this backend")
+	}
+	return s.EmbedFunc(ctx, req)
+}
+
+func (s *Server) Health(ctx context.Context, req *proto.HealthRequest) (*proto.HealthResponse, error) {
+	status := "not ready"
+	if s.ready {
+		status = "ready"
+	}
+	return &proto.HealthResponse{Ready: s.ready, Status: status}, nil
+}
+
+func (s *Server) LoadModel(ctx context.Context, req *proto.LoadModelRequest) (*proto.LoadModelResponse, error) {
+	if s.LoadModelFunc == nil {
+		return nil, fmt.Errorf("grpc: LoadModel not implemented by this backend")
+	}
+	return s.LoadModelFunc(ctx, req)
+}
@@ -0,0 +1,189 @@
+// Package grpc adapts local model servers (llama.cpp, whisper, bert, ...)
+// speaking the LLMService proto to the llm.Strategy interface, so the same
+// Chat/Embed call sites work against a self-hosted model farm without code
+// changes at the caller.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/simp-lee/llmconnector"
+	"github.com/simp-lee/llmconnector/llm/grpc/proto"
+	"google.golang.org/grpc"
+)
+
+// GRPCStrategy is a llm.Strategy backed by a single LLMService endpoint.
+type GRPCStrategy struct {
+	client proto.LLMServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewGRPCStrategy dials target (e.g. "localhost:50051") and returns a
+// Strategy backed by the LLMService it serves.
+func NewGRPCStrategy(target string, opts ...grpc.DialOption) (*GRPCStrategy, error) {
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: failed to dial %q: %w", target, err)
+	}
+
+	return &GRPCStrategy{
+		client: proto.NewLLMServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close releases the underlying gRPC connection.
+func (s *GRPCStrategy) Close() error {
+	return s.conn.Close()
+}
+
+var _ llm.Strategy = (*GRPCStrategy)(nil)
+
+func (s *GRPCStrategy) Chat(ctx context.Context, messages []llm.ChatMessage, options *llm.ChatOptions) (llm.ChatResponse, error) {
+	resp, err := s.client.Chat(ctx, toChatRequest(messages, options))
+	if err != nil {
+		return nil, fmt.Errorf("grpc: chat request failed: %w", err)
+	}
+	return &chatResponse{resp}, nil
+}
+
+func (s *GRPCStrategy) ChatStream(ctx context.Context, messages []llm.ChatMessage, options *llm.ChatOptions) (<-chan llm.ChatStreamChunk, error) {
+	stream, err := s.client.ChatStream(ctx, toChatRequest(messages, options))
+	if err != nil {
+		return nil, fmt.Errorf("grpc: chat stream request failed: %w", err)
+	}
+
+	chunks := make(chan llm.ChatStreamChunk)
+	go func() {
+		defer close(chunks)
+
+		// send delivers chunk to the caller, but gives up and returns false
+		// instead of blocking forever if ctx is cancelled before the
+		// (unbuffered, possibly-undrained) consumer receives it.
+		send := func(chunk llm.ChatStreamChunk) bool {
+			select {
+			case chunks <- chunk:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				send(llm.ChatStreamChunk{Err: fmt.Errorf("grpc: chat stream recv failed: %w", err)})
+				return
+			}
+			if !send(llm.ChatStreamChunk{Content: chunk.Content, Done: chunk.Done}) {
+				return
+			}
+		}
+	}()
+
+	return chunks, nil
+}
+
+func (s *GRPCStrategy) Embed(ctx context.Context, texts []string, options *llm.EmbedOptions) (llm.EmbedResponse, error) {
+	resp, err := s.client.Embed(ctx, &proto.EmbedRequest{
+		Model: options.Model,
+		Texts: texts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("grpc: embed request failed: %w", err)
+	}
+	return &embedResponse{resp}, nil
+}
+
+// Health reports whether the backing model server is ready to serve
+// requests, beyond what the llm.Strategy interface requires.
+func (s *GRPCStrategy) Health(ctx context.Context) (bool, string, error) {
+	resp, err := s.client.Health(ctx, &proto.HealthRequest{})
+	if err != nil {
+		return false, "", fmt.Errorf("grpc: health check failed: %w", err)
+	}
+	return resp.Ready, resp.Status, nil
+}
+
+// LoadModel asks the backing server to load model from path, for backends
+// that load weights lazily rather than at startup.
+func (s *GRPCStrategy) LoadModel(ctx context.Context, model, path string) error {
+	resp, err := s.client.LoadModel(ctx, &proto.LoadModelRequest{Model: model, Path: path})
+	if err != nil {
+		return fmt.Errorf("grpc: load model failed: %w", err)
+	}
+	if !resp.Loaded {
+		return fmt.Errorf("grpc: model %q failed to load: %s", model, resp.Error)
+	}
+	return nil
+}
+
+func toChatRequest(messages []llm.ChatMessage, options *llm.ChatOptions) *proto.ChatRequest {
+	pbMessages := make([]*proto.ChatMessage, len(messages))
+	for i, m := range messages {
+		pbMessages[i] = &proto.ChatMessage{Role: m.Role, Content: m.Content}
+	}
+
+	req := &proto.ChatRequest{
+		Model:    options.Model,
+		Messages: pbMessages,
+		Stop:     options.Stop,
+	}
+	if options.Temperature != nil {
+		temperature := float32(*options.Temperature)
+		req.Temperature = &temperature
+	}
+	if options.MaxTokens != nil {
+		maxTokens := int32(*options.MaxTokens)
+		req.MaxTokens = &maxTokens
+	}
+	if options.TopP != nil {
+		topP := float32(*options.TopP)
+		req.TopP = &topP
+	}
+	return req
+}
+
+type chatResponse struct {
+	resp *proto.ChatResponse
+}
+
+func (r *chatResponse) GetContent() string {
+	return r.resp.Content
+}
+
+func (r *chatResponse) GetUsage() llm.Usage {
+	return usageFromProto(r.resp.Usage)
+}
+
+type embedResponse struct {
+	resp *proto.EmbedResponse
+}
+
+func (r *embedResponse) GetEmbeddings() [][]float32 {
+	embeddings := make([][]float32, len(r.resp.Embeddings))
+	for i, e := range r.resp.Embeddings {
+		embeddings[i] = e.Values
+	}
+	return embeddings
+}
+
+func (r *embedResponse) GetUsage() llm.Usage {
+	return usageFromProto(r.resp.Usage)
+}
+
+func usageFromProto(u *proto.Usage) llm.Usage {
+	if u == nil {
+		return llm.Usage{}
+	}
+	return llm.Usage{
+		PromptTokens:     int(u.PromptTokens),
+		CompletionTokens: int(u.CompletionTokens),
+		TotalTokens:      int(u.TotalTokens),
+	}
+}
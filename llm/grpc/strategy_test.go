@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	llm "github.com/simp-lee/llmconnector"
+	"github.com/simp-lee/llmconnector/llm/grpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dialBufconn(t *testing.T, srv *Server) (*GRPCStrategy, func()) {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	srv.Register(grpcServer)
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+
+	strategy := &GRPCStrategy{client: proto.NewLLMServiceClient(conn), conn: conn}
+	return strategy, func() {
+		conn.Close()
+		grpcServer.Stop()
+	}
+}
+
+// TestGRPCStrategyChatRoundTrip exercises the hand-written LLMService
+// dispatch end to end (client stub -> JSON codec -> ServiceDesc.Methods ->
+// server handler), which is exactly what was missing before this fix: the
+// service descriptor previously registered no Methods/Handler at all, so
+// every unary call returned Unimplemented.
+func TestGRPCStrategyChatRoundTrip(t *testing.T) {
+	srv := &Server{
+		ChatFunc: func(ctx context.Context, req *proto.ChatRequest) (*proto.ChatResponse, error) {
+			return &proto.ChatResponse{
+				Content: "echo:" + req.Messages[0].Content,
+				Usage:   &proto.Usage{PromptTokens: 1, CompletionTokens: 2, TotalTokens: 3},
+			}, nil
+		},
+	}
+	strategy, closeAll := dialBufconn(t, srv)
+	defer closeAll()
+
+	resp, err := strategy.Chat(context.Background(), []llm.ChatMessage{{Role: "user", Content: "hi"}}, &llm.ChatOptions{Model: "local"})
+	if err != nil {
+		t.Fatalf("Chat: %v", err)
+	}
+	if resp.GetContent() != "echo:hi" {
+		t.Fatalf("unexpected content: %q", resp.GetContent())
+	}
+	if resp.GetUsage().TotalTokens != 3 {
+		t.Fatalf("unexpected usage: %+v", resp.GetUsage())
+	}
+}
+
+// TestGRPCStrategyChatStreamRoundTrip exercises the server-streaming
+// dispatch path, which previously had no Handler on its StreamDesc either.
+func TestGRPCStrategyChatStreamRoundTrip(t *testing.T) {
+	srv := &Server{
+		ChatStreamFunc: func(req *proto.ChatRequest, send func(*proto.ChatStreamChunk) error) error {
+			if err := send(&proto.ChatStreamChunk{Content: "a"}); err != nil {
+				return err
+			}
+			return send(&proto.ChatStreamChunk{Content: "b", Done: true})
+		},
+	}
+	strategy, closeAll := dialBufconn(t, srv)
+	defer closeAll()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	chunks, err := strategy.ChatStream(ctx, []llm.ChatMessage{{Role: "user", Content: "hi"}}, &llm.ChatOptions{Model: "local"})
+	if err != nil {
+		t.Fatalf("ChatStream: %v", err)
+	}
+
+	var got []llm.ChatStreamChunk
+	for c := range chunks {
+		got = append(got, c)
+	}
+	if len(got) != 2 || got[0].Content != "a" || !got[1].Done {
+		t.Fatalf("unexpected chunks: %+v", got)
+	}
+}
+
+// TestGRPCStrategyChatStreamClosesChannelWhenConsumerStopsDraining guards
+// against the forwarding goroutine leak this fix addresses: if ctx is
+// cancelled while the consumer has stopped draining the returned channel,
+// the goroutine relaying stream.Recv() into an unbuffered channel must
+// still return (closing the channel) instead of blocking forever on the
+// send.
+func TestGRPCStrategyChatStreamClosesChannelWhenConsumerStopsDraining(t *testing.T) {
+	srv := &Server{
+		ChatStreamFunc: func(req *proto.ChatRequest, send func(*proto.ChatStreamChunk) error) error {
+			if err := send(&proto.ChatStreamChunk{Content: "first"}); err != nil {
+				return err
+			}
+			return send(&proto.ChatStreamChunk{Content: "second"})
+		},
+	}
+	strategy, closeAll := dialBufconn(t, srv)
+	defer closeAll()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chunks, err := strategy.ChatStream(ctx, []llm.ChatMessage{{Role: "user", Content: "hi"}}, &llm.ChatOptions{Model: "local"})
+	if err != nil {
+		t.Fatalf("ChatStream: %v", err)
+	}
+
+	<-chunks // drain exactly one chunk, then stop draining
+	cancel()
+
+	select {
+	case _, ok := <-chunks:
+		if ok {
+			// Either a second buffered chunk or the close signal is fine as
+			// long as the channel doesn't hang; drain once more to confirm
+			// it closes promptly.
+			select {
+			case <-chunks:
+			case <-time.After(2 * time.Second):
+				t.Fatal("channel never closed: forwarding goroutine leaked")
+			}
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("channel never closed: forwarding goroutine leaked")
+	}
+}
+
+// TestGRPCStrategyEmbedRoundTrip exercises the same dispatch for a second
+// unary method, guarding against a fix that only wired up Chat.
+func TestGRPCStrategyEmbedRoundTrip(t *testing.T) {
+	srv := &Server{
+		EmbedFunc: func(ctx context.Context, req *proto.EmbedRequest) (*proto.EmbedResponse, error) {
+			return &proto.EmbedResponse{Embeddings: []*proto.Embedding{{Values: []float32{1, 2, 3}}}}, nil
+		},
+	}
+	strategy, closeAll := dialBufconn(t, srv)
+	defer closeAll()
+
+	resp, err := strategy.Embed(context.Background(), []string{"hi"}, &llm.EmbedOptions{Model: "local"})
+	if err != nil {
+		t.Fatalf("Embed: %v", err)
+	}
+	if len(resp.GetEmbeddings()) != 1 || resp.GetEmbeddings()[0][2] != 3 {
+		t.Fatalf("unexpected embeddings: %+v", resp.GetEmbeddings())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/simp-lee/gohttpclient"
 	"time"
 )
 
@@ -12,12 +11,16 @@ type OpenAIConfig struct {
 	APIKey   string
 	ChatURL  string
 	EmbedURL string
+
+	// MaxRetries bounds how many times a 429/5xx response is retried,
+	// honoring the server's rate-limit headers between attempts. Defaults
+	// to 3 when unset.
+	MaxRetries int
 }
 
 type OpenAIStrategy struct {
-	chatClient  *gohttpclient.Client
-	embedClient *gohttpclient.Client
-	config      OpenAIConfig
+	httpClient *jsonHTTPClient
+	config     OpenAIConfig
 }
 
 func NewOpenAIStrategy(config OpenAIConfig) (*OpenAIStrategy, error) {
@@ -32,27 +35,13 @@ func NewOpenAIStrategy(config OpenAIConfig) (*OpenAIStrategy, error) {
 	if config.EmbedURL == "" {
 		config.EmbedURL = "https://api.openai.com/v1/engines/text-similarity/embeddings"
 	}
-
-	// Prepare the chat client
-	chatClient := gohttpclient.NewClient(
-		gohttpclient.WithTimeout(30*time.Second),
-		gohttpclient.WithRetries(3),
-	)
-	chatClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s", config.APIKey))
-	chatClient.SetHeader("Content-Type", "application/json")
-
-	// Prepare the embedding client
-	embedClient := gohttpclient.NewClient(
-		gohttpclient.WithTimeout(30*time.Second),
-		gohttpclient.WithRetries(3),
-	)
-	embedClient.SetHeader("Authorization", fmt.Sprintf("Bearer %s", config.APIKey))
-	embedClient.SetHeader("Content-Type", "application/json")
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
 
 	return &OpenAIStrategy{
-		chatClient:  chatClient,
-		embedClient: embedClient,
-		config:      config,
+		httpClient: newJSONHTTPClient(config.APIKey, 30*time.Second),
+		config:     config,
 	}, nil
 }
 
@@ -73,8 +62,11 @@ func (s *OpenAIStrategy) Chat(ctx context.Context, chatMessages []ChatMessage, o
 	if options.Stop != nil {
 		request["stop"] = options.Stop
 	}
+	for field, value := range toolsRequestFields(options) {
+		request[field] = value
+	}
 
-	resp, err := s.chatClient.Post(ctx, s.config.ChatURL, request)
+	resp, rateLimit, err := postWithRateLimitRetry(ctx, s.httpClient, s.config.ChatURL, request, s.config.MaxRetries)
 	if err != nil {
 		return nil, fmt.Errorf("OpenAI chat request failed: %w", err)
 	}
@@ -83,6 +75,7 @@ func (s *OpenAIStrategy) Chat(ctx context.Context, chatMessages []ChatMessage, o
 	if err := json.Unmarshal(resp, &openAIResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal OpenAI chat response: %w", err)
 	}
+	openAIResp.rateLimit = rateLimit
 
 	return &openAIResp, nil
 }
@@ -90,9 +83,19 @@ func (s *OpenAIStrategy) Chat(ctx context.Context, chatMessages []ChatMessage, o
 type OpenAIChatResponse struct {
 	Choices []struct {
 		Message struct {
-			Content string `json:"content"`
+			Content   string `json:"content"`
+			ToolCalls []struct {
+				ID       string `json:"id"`
+				Function struct {
+					Name      string `json:"name"`
+					Arguments string `json:"arguments"`
+				} `json:"function"`
+			} `json:"tool_calls"`
 		} `json:"message"`
 	} `json:"choices"`
+	Usage Usage `json:"usage"`
+
+	rateLimit RateLimitInfo
 }
 
 func (r *OpenAIChatResponse) GetContent() string {
@@ -102,42 +105,106 @@ func (r *OpenAIChatResponse) GetContent() string {
 	return ""
 }
 
+// GetRateLimit returns the rate-limit headers OpenAI sent with this
+// response, so callers can throttle subsequent requests client-side.
+func (r *OpenAIChatResponse) GetRateLimit() RateLimitInfo {
+	return r.rateLimit
+}
+
+// Embed batches texts (bounded by options.MaxItemsPerBatch/MaxTokensPerBatch
+// as measured by options.Tokenizer) and dispatches the batches concurrently
+// (up to options.MaxConcurrency at a time), preserving input order in the
+// result and merging each batch's usage into a single total.
 func (s *OpenAIStrategy) Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error) {
+	tokenizer := options.Tokenizer
+	if tokenizer == nil {
+		tokenizer = defaultTokenizer()
+	}
+	maxItems := options.MaxItemsPerBatch
+	if maxItems <= 0 {
+		maxItems = defaultMaxItemsPerEmbedBatch
+	}
+	maxTokens := options.MaxTokensPerBatch
+	if maxTokens <= 0 {
+		maxTokens = defaultMaxTokensPerEmbedBatch
+	}
+
+	batches := splitEmbedBatches(texts, tokenizer, maxItems, maxTokens)
+
+	embeddings, usage, rateLimit, err := runEmbedBatches(ctx, batches, len(texts), options.MaxConcurrency,
+		func(ctx context.Context, batchTexts []string) ([][]float32, Usage, RateLimitInfo, error) {
+			return s.embedBatch(ctx, batchTexts, options)
+		})
+	if err != nil {
+		return nil, fmt.Errorf("OpenAI embed request failed: %w", err)
+	}
+
+	if options.Normalize {
+		for _, embedding := range embeddings {
+			normalizeL2(embedding)
+		}
+	}
+
+	return &OpenAIEmbedResponse{embeddings: embeddings, Usage: usage, rateLimit: rateLimit}, nil
+}
+
+// embedBatch sends a single request for one sub-batch of texts, using the
+// real OpenAI embeddings schema ("input" as a plain array).
+func (s *OpenAIStrategy) embedBatch(ctx context.Context, texts []string, options *EmbedOptions) ([][]float32, Usage, RateLimitInfo, error) {
 	request := map[string]interface{}{
 		"model": options.Model,
-		"input": map[string]interface{}{
-			"texts": texts,
-		},
+		"input": texts,
 	}
 	if options.EmbeddingType != "" {
 		request["params"] = map[string]string{
 			"text_type": options.EmbeddingType,
 		}
 	}
+	if options.Dimensions > 0 {
+		request["dimensions"] = options.Dimensions
+	}
 
-	resp, err := s.embedClient.Post(ctx, s.config.EmbedURL, request)
+	resp, rateLimit, err := postWithRateLimitRetry(ctx, s.httpClient, s.config.EmbedURL, request, s.config.MaxRetries)
 	if err != nil {
-		return nil, fmt.Errorf("OpenAI embed request failed: %w", err)
+		return nil, Usage{}, rateLimit, err
 	}
 
-	var openAIResp OpenAIEmbedResponse
+	var openAIResp openAIEmbedBatchResponse
 	if err := json.Unmarshal(resp, &openAIResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal OpenAI embed response: %w", err)
+		return nil, Usage{}, rateLimit, fmt.Errorf("failed to unmarshal OpenAI embed response: %w", err)
 	}
 
-	return &openAIResp, nil
+	embeddings := make([][]float32, len(openAIResp.Data))
+	for i, data := range openAIResp.Data {
+		embeddings[i] = data.Embedding
+	}
+	return embeddings, openAIResp.Usage, rateLimit, nil
 }
 
-type OpenAIEmbedResponse struct {
+// openAIEmbedBatchResponse is the raw shape of a single embeddings API
+// response, before batches are merged back into input order.
+type openAIEmbedBatchResponse struct {
 	Data []struct {
 		Embedding []float32 `json:"embedding"`
 	} `json:"data"`
+	Usage Usage `json:"usage"`
+}
+
+// OpenAIEmbedResponse is the merged result of one or more embedding
+// batches, in the caller's original input order.
+type OpenAIEmbedResponse struct {
+	embeddings [][]float32
+	Usage      Usage
+
+	rateLimit RateLimitInfo
 }
 
 func (r *OpenAIEmbedResponse) GetEmbeddings() [][]float32 {
-	embeddings := make([][]float32, len(r.Data))
-	for i, data := range r.Data {
-		embeddings[i] = data.Embedding
-	}
-	return embeddings
-}
\ No newline at end of file
+	return r.embeddings
+}
+
+// GetRateLimit returns the rate-limit headers OpenAI sent with this
+// response, so callers can throttle subsequent requests client-side.
+func (r *OpenAIEmbedResponse) GetRateLimit() RateLimitInfo {
+	return r.rateLimit
+}
@@ -0,0 +1,72 @@
+package llm
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitInfo is the parsed form of OpenAI's rate-limit response headers,
+// surfaced to callers so they can throttle client-side before hitting 429s.
+type RateLimitInfo struct {
+	RemainingRequests int
+	RemainingTokens   int
+	ResetRequests     time.Duration
+	ResetTokens       time.Duration
+	RetryAfter        time.Duration
+}
+
+// parseRateLimitHeaders reads OpenAI's x-ratelimit-* and retry-after
+// headers into a RateLimitInfo. Missing or unparsable headers are left at
+// their zero value.
+func parseRateLimitHeaders(header http.Header) RateLimitInfo {
+	return RateLimitInfo{
+		RemainingRequests: atoiOrZero(header.Get("x-ratelimit-remaining-requests")),
+		RemainingTokens:   atoiOrZero(header.Get("x-ratelimit-remaining-tokens")),
+		ResetRequests:     parseRateLimitDuration(header.Get("x-ratelimit-reset-requests")),
+		ResetTokens:       parseRateLimitDuration(header.Get("x-ratelimit-reset-tokens")),
+		RetryAfter:        parseRetryAfter(header.Get("retry-after")),
+	}
+}
+
+func atoiOrZero(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// parseRateLimitDuration parses OpenAI's reset duration strings, e.g.
+// "1s", "6m0s", "7ms".
+func parseRateLimitDuration(s string) time.Duration {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// parseRetryAfter parses the standard Retry-After header, which OpenAI
+// sends as a whole number of seconds.
+func parseRetryAfter(s string) time.Duration {
+	seconds, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// retryDelay picks how long to sleep before the next attempt: the server's
+// suggested Retry-After/reset-requests duration when positive, otherwise the
+// exponential fallback. It does not look at any deadline; the caller stops
+// waiting when ctx is done.
+func retryDelay(info RateLimitInfo, fallback time.Duration) time.Duration {
+	delay := fallback
+	if info.RetryAfter > 0 {
+		delay = info.RetryAfter
+	} else if info.ResetRequests > 0 {
+		delay = info.ResetRequests
+	}
+	return delay
+}
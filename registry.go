@@ -0,0 +1,114 @@
+package llm
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ProviderMetrics accumulates per-provider call statistics for the Registry.
+// TotalLatencyNanos is a time.Duration's int64 nanosecond count, stored
+// as int64 (rather than time.Duration) so it can be updated with
+// atomic.AddInt64 like every other field here.
+type ProviderMetrics struct {
+	Requests          int64
+	Errors            int64
+	TotalLatencyNanos int64
+	PromptTokens      int64
+	CompletionTokens  int64
+}
+
+// AverageLatency returns the mean latency across recorded requests, or 0 if
+// none have been recorded yet.
+func (m *ProviderMetrics) AverageLatency() time.Duration {
+	requests := atomic.LoadInt64(&m.Requests)
+	if requests == 0 {
+		return 0
+	}
+	return time.Duration(atomic.LoadInt64(&m.TotalLatencyNanos) / requests)
+}
+
+// Registry holds named Strategy implementations and tracks per-provider
+// metrics, so a single ChatOptions.Provider value can select any registered
+// backend (OpenAI, Azure, Anthropic, Ollama, LocalAI, ...) at call time.
+type Registry struct {
+	mu         sync.RWMutex
+	strategies map[string]Strategy
+	metrics    map[string]*ProviderMetrics
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		strategies: make(map[string]Strategy),
+		metrics:    make(map[string]*ProviderMetrics),
+	}
+}
+
+// Register adds or replaces the Strategy available under name.
+func (r *Registry) Register(name string, strategy Strategy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.strategies[name] = strategy
+	if _, ok := r.metrics[name]; !ok {
+		r.metrics[name] = &ProviderMetrics{}
+	}
+}
+
+// Resolve returns the Strategy registered under name.
+func (r *Registry) Resolve(name string) (Strategy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	strategy, ok := r.strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("llm: no strategy registered for provider %q", name)
+	}
+	return strategy, nil
+}
+
+// Names returns the currently registered provider names.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.strategies))
+	for name := range r.strategies {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Metrics returns a snapshot of the accumulated metrics for name, or nil if
+// name has never been registered.
+func (r *Registry) Metrics(name string) *ProviderMetrics {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.metrics[name]
+	if !ok {
+		return nil
+	}
+	snapshot := *m
+	return &snapshot
+}
+
+// record updates the metrics for name after a call completes.
+func (r *Registry) record(name string, latency time.Duration, usage Usage, err error) {
+	r.mu.RLock()
+	m, ok := r.metrics[name]
+	r.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	atomic.AddInt64(&m.Requests, 1)
+	if err != nil {
+		atomic.AddInt64(&m.Errors, 1)
+	}
+	atomic.AddInt64(&m.PromptTokens, int64(usage.PromptTokens))
+	atomic.AddInt64(&m.CompletionTokens, int64(usage.CompletionTokens))
+	atomic.AddInt64(&m.TotalLatencyNanos, int64(latency))
+}
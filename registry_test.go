@@ -0,0 +1,36 @@
+package llm
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestRegistryRecordConcurrent exercises record from many goroutines for
+// the same provider, the situation a RouterStrategy serving concurrent
+// requests creates naturally. Run with -race: TotalLatencyNanos must be
+// updated atomically like every other ProviderMetrics field, not via a
+// plain += under only a read lock.
+func TestRegistryRecordConcurrent(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("a", &fakeStrategy{})
+
+	const goroutines = 50
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			registry.record("a", time.Millisecond, Usage{PromptTokens: 1}, nil)
+		}()
+	}
+	wg.Wait()
+
+	m := registry.Metrics("a")
+	if m.Requests != goroutines {
+		t.Fatalf("expected %d requests, got %d", goroutines, m.Requests)
+	}
+	if m.TotalLatencyNanos != int64(goroutines)*int64(time.Millisecond) {
+		t.Fatalf("expected %d total latency nanos, got %d", int64(goroutines)*int64(time.Millisecond), m.TotalLatencyNanos)
+	}
+}
@@ -0,0 +1,54 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const defaultRetryBackoff = 500 * time.Millisecond
+
+// postWithRateLimitRetry POSTs request to url via client, retrying up to
+// maxRetries times on a 429/5xx response. Each retry sleeps for the
+// duration OpenAI suggests via retry-after/x-ratelimit-reset-requests (or
+// an exponential fallback if neither header is present), never sleeping
+// past ctx's deadline. It returns the response body and the parsed
+// rate-limit headers from the final attempt.
+func postWithRateLimitRetry(ctx context.Context, client *jsonHTTPClient, url string, request any, maxRetries int) ([]byte, RateLimitInfo, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		body, header, err := client.Post(ctx, url, request)
+		rateLimit := parseRateLimitHeaders(header)
+		if err == nil {
+			return body, rateLimit, nil
+		}
+		lastErr = err
+
+		if !isRetryableStatus(err) || attempt == maxRetries {
+			return nil, rateLimit, err
+		}
+
+		delay := retryDelay(rateLimit, defaultRetryBackoff*time.Duration(1<<uint(attempt)))
+		select {
+		case <-ctx.Done():
+			return nil, rateLimit, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, RateLimitInfo{}, lastErr
+}
+
+// isRetryableStatus reports whether err represents a 429 or 5xx HTTP
+// response, based on the actual numeric status code jsonHTTPClient
+// returns — not the response body text, which may itself contain digits
+// that look like a status code (e.g. a 400 body mentioning a "500 token
+// limit").
+func isRetryableStatus(err error) bool {
+	var statusErr *httpStatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	code := statusErr.StatusCode
+	return code == 429 || (code >= 500 && code <= 599)
+}
@@ -0,0 +1,96 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestPostWithRateLimitRetryHonorsRetryAfter exercises
+// postWithRateLimitRetry against a real HTTP server: the first response is
+// a 429 carrying Retry-After and rate-limit headers, the second succeeds.
+// This is the path that used to call a gohttpclient.Client method
+// (PostWithHeaders) that didn't exist on the real dependency.
+func TestPostWithRateLimitRetryHonorsRetryAfter(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.Header().Set("x-ratelimit-remaining-requests", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error":"rate limited"}`))
+			return
+		}
+		w.Header().Set("x-ratelimit-remaining-requests", "59")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := newJSONHTTPClient("test-key", 5*time.Second)
+	start := time.Now()
+	body, rateLimit, err := postWithRateLimitRetry(context.Background(), client, server.URL, map[string]string{"model": "gpt-4"}, 3)
+	if err != nil {
+		t.Fatalf("expected eventual success, got error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the retry to wait the 1s Retry-After, waited %v", elapsed)
+	}
+	if requests != 2 {
+		t.Fatalf("expected exactly 2 requests (1 retry), got %d", requests)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+	if rateLimit.RemainingRequests != 59 {
+		t.Fatalf("expected rate limit info from the final response, got %+v", rateLimit)
+	}
+}
+
+// TestPostWithRateLimitRetryGivesUpOnNonRetryableError confirms a
+// non-retryable status (e.g. 400) fails fast without consuming the retry
+// budget.
+func TestPostWithRateLimitRetryGivesUpOnNonRetryableError(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"bad request"}`))
+	}))
+	defer server.Close()
+
+	client := newJSONHTTPClient("test-key", 5*time.Second)
+	_, _, err := postWithRateLimitRetry(context.Background(), client, server.URL, map[string]string{"model": "gpt-4"}, 3)
+	if err == nil {
+		t.Fatal("expected an error for a 400 response")
+	}
+	if requests != 1 {
+		t.Fatalf("expected no retries for a non-retryable status, got %d requests", requests)
+	}
+}
+
+// TestPostWithRateLimitRetryIgnoresStatusDigitsInBody guards against
+// classifying retryability from the response body text: a 400 whose body
+// happens to mention "500" (e.g. a token-limit message) must not be
+// retried just because that digit string appears in the error message.
+func TestPostWithRateLimitRetryIgnoresStatusDigitsInBody(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"request exceeds 500 token limit"}`))
+	}))
+	defer server.Close()
+
+	client := newJSONHTTPClient("test-key", 5*time.Second)
+	_, _, err := postWithRateLimitRetry(context.Background(), client, server.URL, map[string]string{"model": "gpt-4"}, 3)
+	if err == nil {
+		t.Fatal("expected an error for a 400 response")
+	}
+	if requests != 1 {
+		t.Fatalf("expected no retries despite the body containing \"500\", got %d requests", requests)
+	}
+}
@@ -0,0 +1,219 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RoutingPolicy selects which registered provider should handle a request.
+type RoutingPolicy interface {
+	// Select returns the provider name to try first for the given model.
+	Select(model string) (string, error)
+}
+
+// RoundRobinPolicy cycles through providers in the order given.
+type RoundRobinPolicy struct {
+	providers []string
+	next      uint64
+}
+
+// NewRoundRobinPolicy returns a RoundRobinPolicy cycling over providers.
+func NewRoundRobinPolicy(providers ...string) *RoundRobinPolicy {
+	return &RoundRobinPolicy{providers: providers}
+}
+
+func (p *RoundRobinPolicy) Select(model string) (string, error) {
+	if len(p.providers) == 0 {
+		return "", fmt.Errorf("llm: round-robin policy has no providers")
+	}
+	i := atomic.AddUint64(&p.next, 1) - 1
+	return p.providers[i%uint64(len(p.providers))], nil
+}
+
+// WeightedPolicy picks a provider with probability proportional to its
+// weight, using a deterministic round-robin-over-weighted-slots scheme so
+// Select needs no RNG.
+type WeightedPolicy struct {
+	slots []string
+	next  uint64
+}
+
+// NewWeightedPolicy builds a WeightedPolicy from provider->weight pairs.
+// Weights are relative; a provider with weight 2 is selected twice as
+// often as one with weight 1.
+func NewWeightedPolicy(weights map[string]int) *WeightedPolicy {
+	var slots []string
+	for name, weight := range weights {
+		for i := 0; i < weight; i++ {
+			slots = append(slots, name)
+		}
+	}
+	return &WeightedPolicy{slots: slots}
+}
+
+func (p *WeightedPolicy) Select(model string) (string, error) {
+	if len(p.slots) == 0 {
+		return "", fmt.Errorf("llm: weighted policy has no providers")
+	}
+	i := atomic.AddUint64(&p.next, 1) - 1
+	return p.slots[i%uint64(len(p.slots))], nil
+}
+
+// ModelPrefixPolicy routes by matching the model name against prefixes,
+// e.g. "gpt-" -> "openai", "claude-" -> "anthropic", falling back to
+// Default when nothing matches.
+type ModelPrefixPolicy struct {
+	Prefixes map[string]string
+	Default  string
+}
+
+func (p *ModelPrefixPolicy) Select(model string) (string, error) {
+	for prefix, provider := range p.Prefixes {
+		if strings.HasPrefix(model, prefix) {
+			return provider, nil
+		}
+	}
+	if p.Default != "" {
+		return p.Default, nil
+	}
+	return "", fmt.Errorf("llm: no provider matches model %q", model)
+}
+
+// RouterStrategy dispatches to the providers registered in a Registry
+// according to a RoutingPolicy, retrying on other registered providers with
+// exponential backoff when a call fails with a retryable (429/5xx) error.
+type RouterStrategy struct {
+	registry   *Registry
+	policy     RoutingPolicy
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// NewRouterStrategy builds a RouterStrategy over registry using policy to
+// pick the first provider to try. Failed attempts fail over to the next
+// registered provider, retrying up to maxRetries times with exponential
+// backoff starting at baseDelay.
+func NewRouterStrategy(registry *Registry, policy RoutingPolicy, maxRetries int, baseDelay time.Duration) *RouterStrategy {
+	return &RouterStrategy{
+		registry:   registry,
+		policy:     policy,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+}
+
+var _ Strategy = (*RouterStrategy)(nil)
+
+func (r *RouterStrategy) Chat(ctx context.Context, messages []ChatMessage, options *ChatOptions) (ChatResponse, error) {
+	var resp ChatResponse
+	err := r.dispatch(ctx, options.Provider, options.Model, func(name string, strategy Strategy) error {
+		start := time.Now()
+		var callErr error
+		resp, callErr = strategy.Chat(ctx, messages, options)
+		r.registry.record(name, time.Since(start), usageOrZero(resp), callErr)
+		return callErr
+	})
+	return resp, err
+}
+
+func (r *RouterStrategy) ChatStream(ctx context.Context, messages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error) {
+	var chunks <-chan ChatStreamChunk
+	err := r.dispatch(ctx, options.Provider, options.Model, func(name string, strategy Strategy) error {
+		start := time.Now()
+		var callErr error
+		chunks, callErr = strategy.ChatStream(ctx, messages, options)
+		r.registry.record(name, time.Since(start), Usage{}, callErr)
+		return callErr
+	})
+	return chunks, err
+}
+
+func (r *RouterStrategy) Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error) {
+	var resp EmbedResponse
+	err := r.dispatch(ctx, "", options.Model, func(name string, strategy Strategy) error {
+		start := time.Now()
+		var callErr error
+		resp, callErr = strategy.Embed(ctx, texts, options)
+		r.registry.record(name, time.Since(start), usageOrZero(resp), callErr)
+		return callErr
+	})
+	return resp, err
+}
+
+// dispatch starts at provider when it is non-empty, otherwise at the
+// provider the policy selects for model, then walks the registry's
+// providers (starting there), retrying each up to maxRetries times, until
+// call succeeds, a non-retryable error occurs, or every provider has
+// failed.
+func (r *RouterStrategy) dispatch(ctx context.Context, provider, model string, call func(name string, strategy Strategy) error) error {
+	first := provider
+	if first == "" {
+		selected, err := r.policy.Select(model)
+		if err != nil {
+			return err
+		}
+		first = selected
+	}
+
+	order := append([]string{first}, otherProviders(r.registry.Names(), first)...)
+
+	var lastErr error
+	for _, name := range order {
+		strategy, err := r.registry.Resolve(name)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		attempts := 0
+		for attempts <= r.maxRetries {
+			lastErr = call(name, strategy)
+			if lastErr == nil {
+				return nil
+			}
+			if !isRetryableStatus(lastErr) {
+				return lastErr
+			}
+
+			attempts++
+			if attempts > r.maxRetries {
+				break
+			}
+
+			delay := r.baseDelay * time.Duration(1<<uint(attempts-1))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	return fmt.Errorf("llm: all providers failed: %w", lastErr)
+}
+
+func otherProviders(all []string, exclude string) []string {
+	others := make([]string, 0, len(all))
+	for _, name := range all {
+		if name != exclude {
+			others = append(others, name)
+		}
+	}
+	return others
+}
+
+type usageGetter interface {
+	GetUsage() Usage
+}
+
+// usageOrZero extracts Usage from a ChatResponse/EmbedResponse for metrics
+// recording, returning the zero value for nil or usage-less responses.
+func usageOrZero(v any) Usage {
+	if u, ok := v.(usageGetter); ok {
+		return u.GetUsage()
+	}
+	return Usage{}
+}
@@ -0,0 +1,77 @@
+package llm
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeStrategy struct {
+	calls int
+	fail  error
+}
+
+func (f *fakeStrategy) Chat(ctx context.Context, messages []ChatMessage, options *ChatOptions) (ChatResponse, error) {
+	f.calls++
+	if f.fail != nil {
+		return nil, f.fail
+	}
+	return &OpenAIChatResponse{}, nil
+}
+
+func (f *fakeStrategy) ChatStream(ctx context.Context, messages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error) {
+	f.calls++
+	return nil, f.fail
+}
+
+func (f *fakeStrategy) Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error) {
+	f.calls++
+	return nil, f.fail
+}
+
+// TestRouterStrategyFailsOverAcrossProviders ensures a provider whose own
+// retry budget is exhausted doesn't stop the walk before the next
+// registered provider gets a chance to serve the call.
+func TestRouterStrategyFailsOverAcrossProviders(t *testing.T) {
+	registry := NewRegistry()
+	failing := &fakeStrategy{fail: &httpStatusError{StatusCode: 503, Body: []byte("upstream unavailable")}}
+	healthy := &fakeStrategy{}
+	registry.Register("a", failing)
+	registry.Register("b", healthy)
+
+	router := NewRouterStrategy(registry, &ModelPrefixPolicy{Default: "a"}, 1, time.Millisecond)
+
+	resp, err := router.Chat(context.Background(), nil, &ChatOptions{Model: "gpt-4"})
+	if err != nil {
+		t.Fatalf("expected failover to provider b to succeed, got error: %v", err)
+	}
+	if resp == nil {
+		t.Fatal("expected a non-nil response from the healthy provider")
+	}
+	if healthy.calls != 1 {
+		t.Fatalf("expected provider b to be called once, got %d", healthy.calls)
+	}
+	if failing.calls != 2 { // initial attempt + 1 retry, per maxRetries=1
+		t.Fatalf("expected provider a to be retried up to maxRetries, got %d calls", failing.calls)
+	}
+}
+
+// TestRouterStrategyDoesNotRetryNonRetryableStatus guards against
+// dispatch misclassifying a permanent 4xx error as retryable just because
+// its message happens to contain digits that look like a retryable status
+// code (e.g. a 400 body mentioning "500 token limit").
+func TestRouterStrategyDoesNotRetryNonRetryableStatus(t *testing.T) {
+	registry := NewRegistry()
+	failing := &fakeStrategy{fail: &httpStatusError{StatusCode: 400, Body: []byte("request exceeds 500 token limit")}}
+	registry.Register("a", failing)
+
+	router := NewRouterStrategy(registry, &ModelPrefixPolicy{Default: "a"}, 3, time.Millisecond)
+
+	_, err := router.Chat(context.Background(), nil, &ChatOptions{Model: "gpt-4"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if failing.calls != 1 {
+		t.Fatalf("expected exactly 1 call (no retries for a non-retryable status), got %d", failing.calls)
+	}
+}
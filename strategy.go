@@ -0,0 +1,14 @@
+package llm
+
+import "context"
+
+// Strategy is the common contract implemented by every LLM backend
+// (OpenAI, Azure, Anthropic, Ollama, a gRPC-backed local server, ...) so
+// callers and the Registry/RouterStrategy can treat them interchangeably.
+type Strategy interface {
+	Chat(ctx context.Context, messages []ChatMessage, options *ChatOptions) (ChatResponse, error)
+	ChatStream(ctx context.Context, messages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error)
+	Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error)
+}
+
+var _ Strategy = (*OpenAIStrategy)(nil)
@@ -0,0 +1,34 @@
+package llm
+
+// Tokenizer counts how many tokens a model would consume for a given text,
+// so embedding batches can be bounded by a token budget rather than just an
+// item count.
+type Tokenizer interface {
+	CountTokens(text string) int
+}
+
+// approxCharCountTokenizer is a lightweight stand-in for a real
+// tiktoken-style BPE tokenizer: it estimates token count from UTF-8 rune
+// count rather than running an actual BPE merge table, which is close
+// enough for batch-sizing purposes without shipping a vocabulary. The
+// estimate doesn't vary by model because OpenAI's current embedding
+// models (text-embedding-3-*, text-embedding-ada-002) all share the same
+// cl100k_base encoding.
+type approxCharCountTokenizer struct {
+	charsPerToken float64
+}
+
+// defaultTokenizer returns the Tokenizer used to size batches when the
+// caller doesn't supply one explicitly via EmbedOptions.Tokenizer.
+func defaultTokenizer() Tokenizer {
+	return &approxCharCountTokenizer{charsPerToken: 4}
+}
+
+func (t *approxCharCountTokenizer) CountTokens(text string) int {
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return 0
+	}
+	tokens := int(float64(len(runes))/t.charsPerToken) + 1
+	return tokens
+}
@@ -0,0 +1,20 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApproxCharCountTokenizer(t *testing.T) {
+	tok := defaultTokenizer()
+
+	if got := tok.CountTokens(""); got != 0 {
+		t.Fatalf("empty text: expected 0 tokens, got %d", got)
+	}
+
+	short := tok.CountTokens("abcd")
+	long := tok.CountTokens(strings.Repeat("a", 40))
+	if long <= short {
+		t.Fatalf("expected longer text to count more tokens: short=%d long=%d", short, long)
+	}
+}
@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/simp-lee/llmconnector/jsonschema"
+)
+
+// Tool describes a single callable function offered to the model, mirroring
+// OpenAI's `tools[].function` shape.
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  jsonschema.Definition
+}
+
+// ToolCall is a single function invocation requested by the assistant.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string // raw JSON, unmarshal with ToolCall.Unmarshal
+}
+
+// Unmarshal decodes the raw JSON arguments into v.
+func (c ToolCall) Unmarshal(v any) error {
+	if err := json.Unmarshal([]byte(c.Arguments), v); err != nil {
+		return fmt.Errorf("failed to unmarshal tool call arguments: %w", err)
+	}
+	return nil
+}
+
+// ResponseFormat constrains the assistant's reply to a particular shape.
+// When Schema is set, Type should be "json_schema"; when only JSON is
+// requested without a schema, Type should be "json_object".
+type ResponseFormat struct {
+	Type   string
+	Name   string
+	Schema *jsonschema.Definition
+}
+
+// ResponseFormatForStruct builds a json_schema ResponseFormat by reflecting
+// over v, so callers can get guaranteed-parseable JSON back into a Go type
+// without hand-writing a schema.
+func ResponseFormatForStruct(name string, v any) (ResponseFormat, error) {
+	def, err := jsonschema.DefinitionFromStruct(v)
+	if err != nil {
+		return ResponseFormat{}, fmt.Errorf("failed to build response format schema: %w", err)
+	}
+	return ResponseFormat{Type: "json_schema", Name: name, Schema: &def}, nil
+}
+
+func toolsRequestFields(options *ChatOptions) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	if len(options.Tools) > 0 {
+		tools := make([]map[string]interface{}, len(options.Tools))
+		for i, tool := range options.Tools {
+			tools[i] = map[string]interface{}{
+				"type": "function",
+				"function": map[string]interface{}{
+					"name":        tool.Name,
+					"description": tool.Description,
+					"parameters":  tool.Parameters,
+				},
+			}
+		}
+		fields["tools"] = tools
+	}
+	if options.ToolChoice != "" {
+		fields["tool_choice"] = options.ToolChoice
+	}
+	if options.ResponseFormat != nil {
+		rf := map[string]interface{}{"type": options.ResponseFormat.Type}
+		if options.ResponseFormat.Schema != nil {
+			rf["json_schema"] = map[string]interface{}{
+				"name":   options.ResponseFormat.Name,
+				"schema": options.ResponseFormat.Schema,
+				"strict": true,
+			}
+		}
+		fields["response_format"] = rf
+	}
+
+	return fields
+}
+
+// GetToolCalls returns the tool calls requested by the assistant on the
+// first choice, if any.
+func (r *OpenAIChatResponse) GetToolCalls() []ToolCall {
+	if len(r.Choices) == 0 {
+		return nil
+	}
+
+	raw := r.Choices[0].Message.ToolCalls
+	if len(raw) == 0 {
+		return nil
+	}
+
+	calls := make([]ToolCall, len(raw))
+	for i, c := range raw {
+		calls[i] = ToolCall{
+			ID:        c.ID,
+			Name:      c.Function.Name,
+			Arguments: c.Function.Arguments,
+		}
+	}
+	return calls
+}
@@ -0,0 +1,70 @@
+package llm
+
+// ChatMessage is a single message in a chat completion request, following
+// the OpenAI role/content shape ("system", "user", "assistant", "tool").
+type ChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// ChatOptions configures a Chat/ChatStream call.
+type ChatOptions struct {
+	Model       string
+	Temperature *float64
+	MaxTokens   *int
+	TopP        *float64
+	Stop        []string
+
+	// Tools/ToolChoice/ResponseFormat configure function calling and
+	// structured output; see tools.go.
+	Tools          []Tool
+	ToolChoice     string
+	ResponseFormat *ResponseFormat
+
+	// Provider selects which Registry-registered Strategy should serve
+	// this call when going through a RouterStrategy.
+	Provider string
+
+	// Tenant attributes usage/cost tracked by a UsageTracker to a budget.
+	// The zero value is the global, untenanted budget.
+	Tenant string
+}
+
+// ChatResponse is the result of a Chat call.
+type ChatResponse interface {
+	GetContent() string
+	GetUsage() Usage
+}
+
+// EmbedOptions configures an Embed call.
+type EmbedOptions struct {
+	Model         string
+	EmbeddingType string
+
+	// Tenant attributes usage/cost tracked by a UsageTracker to a budget.
+	Tenant string
+
+	// Tokenizer sizes batches; defaultTokenizer() is used when nil.
+	Tokenizer Tokenizer
+
+	// MaxItemsPerBatch/MaxTokensPerBatch bound each sub-batch sent to the
+	// API; defaultMaxItemsPerEmbedBatch/defaultMaxTokensPerEmbedBatch are
+	// used when unset. MaxConcurrency bounds how many sub-batches are in
+	// flight at once; defaultEmbedConcurrency is used when unset.
+	MaxItemsPerBatch  int
+	MaxTokensPerBatch int
+	MaxConcurrency    int
+
+	// Normalize L2-normalizes each returned embedding.
+	Normalize bool
+
+	// Dimensions requests a reduced embedding size, supported by the
+	// text-embedding-3-* models.
+	Dimensions int
+}
+
+// EmbedResponse is the result of an Embed call.
+type EmbedResponse interface {
+	GetEmbeddings() [][]float32
+	GetUsage() Usage
+}
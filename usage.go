@@ -0,0 +1,206 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Usage captures the token accounting an OpenAI-compatible endpoint returns
+// alongside a completion.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// Add returns the element-wise sum of u and other, used to merge usage
+// across batched requests.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		PromptTokens:     u.PromptTokens + other.PromptTokens,
+		CompletionTokens: u.CompletionTokens + other.CompletionTokens,
+		TotalTokens:      u.TotalTokens + other.TotalTokens,
+	}
+}
+
+// GetUsage returns the token usage reported for this chat completion.
+func (r *OpenAIChatResponse) GetUsage() Usage {
+	return r.Usage
+}
+
+// GetUsage returns the token usage reported for this embedding call.
+func (r *OpenAIEmbedResponse) GetUsage() Usage {
+	return r.Usage
+}
+
+// ModelPrice is the per-1K-token price for a model, in USD.
+type ModelPrice struct {
+	InputPer1K  float64
+	OutputPer1K float64
+}
+
+// CostTable maps model name to its per-1K-token pricing.
+type CostTable map[string]ModelPrice
+
+// Cost returns the USD cost of usage for model, or an error if model has no
+// entry in the table.
+func (t CostTable) Cost(model string, usage Usage) (float64, error) {
+	price, ok := t[model]
+	if !ok {
+		return 0, fmt.Errorf("llm: no cost entry for model %q", model)
+	}
+	return float64(usage.PromptTokens)/1000*price.InputPer1K +
+		float64(usage.CompletionTokens)/1000*price.OutputPer1K, nil
+}
+
+// ErrBudgetExceeded is returned by a UsageTracker-wrapped Strategy once its
+// configured budget has been spent.
+var ErrBudgetExceeded = errors.New("llm: usage budget exceeded")
+
+// UsageTracker wraps a Strategy and CostTable to accumulate spend per
+// tenant, pricing each call by its model's CostTable entry, and rejects
+// further calls once a configured budget is exceeded. Spend is not broken
+// down by model.
+type UsageTracker struct {
+	Strategy
+	costTable CostTable
+
+	mu               sync.Mutex
+	spent            map[string]float64 // key: tenant, or "" if untenanted
+	budgets          map[string]float64
+	onBudgetExceeded func(tenant string, spent float64)
+}
+
+// NewUsageTracker wraps strategy, pricing usage against costTable.
+func NewUsageTracker(strategy Strategy, costTable CostTable) *UsageTracker {
+	return &UsageTracker{
+		Strategy:  strategy,
+		costTable: costTable,
+		spent:     make(map[string]float64),
+		budgets:   make(map[string]float64),
+	}
+}
+
+// SetBudget caps spend for tenant at maxUSD. Use "" for a single global
+// budget when callers don't pass a tenant.
+func (t *UsageTracker) SetBudget(tenant string, maxUSD float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.budgets[tenant] = maxUSD
+}
+
+// OnBudgetExceeded registers a callback invoked the moment a tenant's spend
+// crosses its budget.
+func (t *UsageTracker) OnBudgetExceeded(fn func(tenant string, spent float64)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onBudgetExceeded = fn
+}
+
+// Spent returns the accumulated cost for tenant so far.
+func (t *UsageTracker) Spent(tenant string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.spent[tenant]
+}
+
+func (t *UsageTracker) checkBudget(tenant string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	budget, hasBudget := t.budgets[tenant]
+	if hasBudget && t.spent[tenant] >= budget {
+		return ErrBudgetExceeded
+	}
+	return nil
+}
+
+func (t *UsageTracker) record(tenant, model string, usage Usage) {
+	cost, err := t.costTable.Cost(model, usage)
+	if err != nil {
+		return // unpriced model: track tokens elsewhere, don't block on cost
+	}
+
+	t.mu.Lock()
+	t.spent[tenant] += cost
+	exceeded := t.budgets[tenant] > 0 && t.spent[tenant] >= t.budgets[tenant]
+	spent := t.spent[tenant]
+	onExceeded := t.onBudgetExceeded
+	t.mu.Unlock()
+
+	if exceeded && onExceeded != nil {
+		onExceeded(tenant, spent)
+	}
+}
+
+// Chat delegates to the wrapped Strategy, tracking usage/cost for
+// options.Tenant (or the global "" tenant) and returning
+// ErrBudgetExceeded instead of calling through once the budget is spent.
+func (t *UsageTracker) Chat(ctx context.Context, messages []ChatMessage, options *ChatOptions) (ChatResponse, error) {
+	tenant := options.Tenant
+	if err := t.checkBudget(tenant); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.Strategy.Chat(ctx, messages, options)
+	if err != nil {
+		return resp, err
+	}
+
+	t.record(tenant, options.Model, resp.GetUsage())
+	return resp, nil
+}
+
+// ChatStream delegates to the wrapped Strategy, tracking usage/cost for
+// options.Tenant once the stream completes. Usage is only known once the
+// final ChatStreamChunk (carrying Usage) arrives, so unlike Chat/Embed the
+// budget is checked up front but recorded asynchronously as the stream
+// drains; a call already in flight when the budget is crossed is not cut
+// off mid-stream.
+func (t *UsageTracker) ChatStream(ctx context.Context, messages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error) {
+	tenant := options.Tenant
+	if err := t.checkBudget(tenant); err != nil {
+		return nil, err
+	}
+
+	upstream, err := t.Strategy.ChatStream(ctx, messages, options)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make(chan ChatStreamChunk)
+	go func() {
+		defer close(chunks)
+		for chunk := range upstream {
+			if chunk.Done {
+				t.record(tenant, options.Model, chunk.Usage)
+			}
+			select {
+			case chunks <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return chunks, nil
+}
+
+// Embed delegates to the wrapped Strategy, tracking usage/cost for
+// options.Tenant and returning ErrBudgetExceeded once the budget is spent.
+func (t *UsageTracker) Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error) {
+	tenant := options.Tenant
+	if err := t.checkBudget(tenant); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.Strategy.Embed(ctx, texts, options)
+	if err != nil {
+		return resp, err
+	}
+
+	t.record(tenant, options.Model, resp.GetUsage())
+	return resp, nil
+}
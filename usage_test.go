@@ -0,0 +1,75 @@
+package llm
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeStreamStrategy struct {
+	chunks []ChatStreamChunk
+}
+
+func (f *fakeStreamStrategy) Chat(ctx context.Context, messages []ChatMessage, options *ChatOptions) (ChatResponse, error) {
+	return &OpenAIChatResponse{}, nil
+}
+
+func (f *fakeStreamStrategy) ChatStream(ctx context.Context, messages []ChatMessage, options *ChatOptions) (<-chan ChatStreamChunk, error) {
+	out := make(chan ChatStreamChunk, len(f.chunks))
+	for _, c := range f.chunks {
+		out <- c
+	}
+	close(out)
+	return out, nil
+}
+
+func (f *fakeStreamStrategy) Embed(ctx context.Context, texts []string, options *EmbedOptions) (EmbedResponse, error) {
+	return &OpenAIEmbedResponse{}, nil
+}
+
+// TestUsageTrackerChatStreamRecordsUsage ensures a streamed Chat call is
+// metered like a non-streamed one: usage from the final chunk is recorded
+// against the tenant's spend instead of bypassing tracking entirely.
+func TestUsageTrackerChatStreamRecordsUsage(t *testing.T) {
+	strategy := &fakeStreamStrategy{chunks: []ChatStreamChunk{
+		{Content: "hi"},
+		{Done: true, Usage: Usage{PromptTokens: 10, CompletionTokens: 20, TotalTokens: 30}},
+	}}
+	costTable := CostTable{"gpt-4": {InputPer1K: 1, OutputPer1K: 2}}
+	tracker := NewUsageTracker(strategy, costTable)
+
+	chunks, err := tracker.ChatStream(context.Background(), nil, &ChatOptions{Model: "gpt-4"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var count int
+	for range chunks {
+		count++
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 chunks to pass through, got %d", count)
+	}
+
+	want := float64(10)/1000*1 + float64(20)/1000*2
+	if got := tracker.Spent(""); got != want {
+		t.Fatalf("expected recorded spend %v, got %v", want, got)
+	}
+}
+
+// TestUsageTrackerChatStreamRejectsOverBudget confirms ChatStream checks
+// the budget up front rather than always delegating straight through.
+func TestUsageTrackerChatStreamRejectsOverBudget(t *testing.T) {
+	strategy := &fakeStreamStrategy{}
+	tracker := NewUsageTracker(strategy, CostTable{})
+	tracker.SetBudget("", 0)
+	tracker.record("", "gpt-4", Usage{}) // any record attempt is a no-op with an empty cost table
+
+	// Force spend above the zero budget directly, since record() no-ops on
+	// an unpriced model above.
+	tracker.mu.Lock()
+	tracker.spent[""] = 1
+	tracker.mu.Unlock()
+
+	if _, err := tracker.ChatStream(context.Background(), nil, &ChatOptions{Model: "gpt-4"}); err != ErrBudgetExceeded {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+}